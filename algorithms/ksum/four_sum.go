@@ -0,0 +1,6 @@
+package ksum
+
+// FourSum finds all unique quadruplets in nums that sum to target.
+func FourSum(nums []int, target int) [][]int {
+	return KSum(nums, target, 4)
+}
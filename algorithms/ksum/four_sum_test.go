@@ -0,0 +1,33 @@
+package ksum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFourSum(t *testing.T) {
+	tests := []struct {
+		name   string
+		nums   []int
+		target int
+		want   [][]int
+	}{
+		{
+			name:   "classic",
+			nums:   []int{1, 0, -1, 0, -2, 2},
+			target: 0,
+			want:   [][]int{{-2, -1, 1, 2}, {-2, 0, 0, 2}, {-1, 0, 0, 1}},
+		},
+		{"too few elements", []int{1, 2, 3}, 6, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := normalize(FourSum(tt.nums, tt.target))
+			want := normalize(tt.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("FourSum(%v, %d) = %v, want %v", tt.nums, tt.target, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Package ksum generalizes the classic two/three/four-sum family into a
+// single recursive k-sum solver built on a two-pointer base case.
+package ksum
+
+import "sort"
+
+// Options configures KSum's behavior. The zero value matches the classic
+// LeetCode behavior: duplicate tuples are suppressed and the input is
+// sorted by KSum itself.
+type Options struct {
+	// AllowDuplicatesInResult keeps tuples that duplicate an earlier result
+	// instead of skipping repeated pivots and pointer positions.
+	AllowDuplicatesInResult bool
+	// Sorted tells KSum the input is already sorted in non-decreasing order,
+	// letting it skip sorting a copy of nums.
+	Sorted bool
+}
+
+// KSum finds every combination of k elements of nums (by index, each index
+// used at most once) that sums to target. It recurses on the pivot element,
+// reducing k by one each level, down to a two-pointer 2-sum base case on the
+// sorted slice. At every level it skips duplicate pivots (unless
+// opts.AllowDuplicatesInResult is set) and prunes a branch as soon as even
+// the smallest or largest possible remaining k elements can't reach target.
+func KSum(nums []int, target, k int, opts ...Options) [][]int {
+	var o Options
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	if k < 2 || len(nums) < k {
+		return nil
+	}
+
+	if !o.Sorted {
+		sorted := append([]int(nil), nums...)
+		sort.Ints(sorted)
+		nums = sorted
+	}
+
+	return kSum(nums, 0, target, k, o.AllowDuplicatesInResult)
+}
+
+func kSum(nums []int, start, target, k int, allowDup bool) [][]int {
+	n := len(nums)
+	if k == 2 {
+		return twoSumSorted(nums, start, target, allowDup)
+	}
+
+	var result [][]int
+	for i := start; i <= n-k; i++ {
+		if !allowDup && i > start && nums[i] == nums[i-1] {
+			continue
+		}
+
+		if nums[i]+sum(nums[i+1:i+k]) > target {
+			break
+		}
+
+		if nums[i]+sum(nums[n-k+1:n]) < target {
+			continue
+		}
+
+		for _, tail := range kSum(nums, i+1, target-nums[i], k-1, allowDup) {
+			result = append(result, append([]int{nums[i]}, tail...))
+		}
+	}
+
+	return result
+}
+
+func twoSumSorted(nums []int, start, target int, allowDup bool) [][]int {
+	var result [][]int
+	left, right := start, len(nums)-1
+
+	for left < right {
+		total := nums[left] + nums[right]
+		switch {
+		case total == target:
+			result = append(result, []int{nums[left], nums[right]})
+			left++
+			right--
+
+			if !allowDup {
+				for left < right && nums[left] == nums[left-1] {
+					left++
+				}
+				for left < right && nums[right] == nums[right+1] {
+					right--
+				}
+			}
+		case total < target:
+			left++
+		default:
+			right--
+		}
+	}
+
+	return result
+}
+
+func sum(nums []int) int {
+	total := 0
+	for _, v := range nums {
+		total += v
+	}
+
+	return total
+}
@@ -0,0 +1,145 @@
+package ksum
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestKSum(t *testing.T) {
+	tests := []struct {
+		name   string
+		nums   []int
+		target int
+		k      int
+		want   [][]int
+	}{
+		{"two sum", []int{2, 7, 11, 15}, 9, 2, [][]int{{2, 7}}},
+		{"three sum zero", []int{-1, 0, 1, 2, -1, -4}, 0, 3, [][]int{{-1, -1, 2}, {-1, 0, 1}}},
+		{"four sum", []int{1, 0, -1, 0, -2, 2}, 0, 4, [][]int{{-2, -1, 1, 2}, {-2, 0, 0, 2}, {-1, 0, 0, 1}}},
+		{"k larger than len", []int{1, 2}, 3, 3, nil},
+		{"no match", []int{1, 2, 3}, 100, 2, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := normalize(KSum(tt.nums, tt.target, tt.k))
+			want := normalize(tt.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("KSum(%v, %d, %d) = %v, want %v", tt.nums, tt.target, tt.k, got, want)
+			}
+		})
+	}
+}
+
+func TestKSumSortedOption(t *testing.T) {
+	nums := []int{-4, -1, -1, 0, 1, 2}
+	got := normalize(KSum(nums, 0, 3, Options{Sorted: true}))
+	want := normalize([][]int{{-1, -1, 2}, {-1, 0, 1}})
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("KSum with Sorted option = %v, want %v", got, want)
+	}
+}
+
+func TestKSumAllowDuplicatesInResult(t *testing.T) {
+	nums := []int{0, 0, 0, 0}
+
+	got := normalize(KSum(nums, 0, 3, Options{AllowDuplicatesInResult: true}))
+	want := normalize([][]int{{0, 0, 0}, {0, 0, 0}})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("KSum with AllowDuplicatesInResult = %v, want %v", got, want)
+	}
+
+	gotDefault := normalize(KSum(nums, 0, 3))
+	wantDefault := normalize([][]int{{0, 0, 0}})
+	if !reflect.DeepEqual(gotDefault, wantDefault) {
+		t.Errorf("KSum without the option = %v, want %v", gotDefault, wantDefault)
+	}
+}
+
+// normalize sorts the list of tuples, so tests can compare results
+// independent of discovery order. It leaves each tuple's own contents as they
+// are; KSum and bruteForceKSum both return tuples already in ascending order.
+func normalize(tuples [][]int) [][]int {
+	out := append([][]int(nil), tuples...)
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+
+		return len(a) < len(b)
+	})
+
+	return out
+}
+
+func bruteForceKSum(nums []int, target, k int) [][]int {
+	n := len(nums)
+	var result [][]int
+	var combo []int
+
+	seen := make(map[string]bool)
+
+	var choose func(start int)
+	choose = func(start int) {
+		if len(combo) == k {
+			total := 0
+			for _, v := range combo {
+				total += v
+			}
+			if total == target {
+				key := make([]int, k)
+				copy(key, combo)
+				sort.Ints(key)
+
+				keyStr := fmt.Sprint(key)
+				if !seen[keyStr] {
+					seen[keyStr] = true
+					result = append(result, key)
+				}
+			}
+			return
+		}
+
+		for i := start; i < n; i++ {
+			combo = append(combo, nums[i])
+			choose(i + 1)
+			combo = combo[:len(combo)-1]
+		}
+	}
+
+	choose(0)
+	return result
+}
+
+func FuzzKSum(f *testing.F) {
+	f.Add([]byte{0, 1, 2, 3, 4, 5, 6, 7}, int8(0), uint8(3))
+
+	f.Fuzz(func(t *testing.T, raw []byte, target int8, k uint8) {
+		if len(raw) > 8 {
+			raw = raw[:8]
+		}
+
+		kk := int(k%3) + 2 // keep k in [2,4] so brute force stays cheap
+		if len(raw) < kk {
+			return
+		}
+
+		nums := make([]int, len(raw))
+		for i, b := range raw {
+			nums[i] = int(int8(b)) // keep values small
+		}
+
+		got := normalize(KSum(nums, int(target), kk))
+		want := normalize(bruteForceKSum(nums, int(target), kk))
+
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("KSum(%v, %d, %d) = %v, want %v", nums, target, kk, got, want)
+		}
+	})
+}
@@ -0,0 +1,6 @@
+package ksum
+
+// ThreeSum finds all unique triplets in nums that sum to zero.
+func ThreeSum(nums []int) [][]int {
+	return KSum(nums, 0, 3)
+}
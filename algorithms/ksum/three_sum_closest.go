@@ -0,0 +1,41 @@
+package ksum
+
+import "sort"
+
+// ThreeSumClosest returns the sum of the three values in nums whose total is
+// closest to target.
+func ThreeSumClosest(nums []int, target int) int {
+	sorted := append([]int(nil), nums...)
+	sort.Ints(sorted)
+
+	closest := sorted[0] + sorted[1] + sorted[2]
+
+	for i := 0; i < len(sorted)-2; i++ {
+		left, right := i+1, len(sorted)-1
+		for left < right {
+			total := sorted[i] + sorted[left] + sorted[right]
+			if abs(total-target) < abs(closest-target) {
+				closest = total
+			}
+
+			switch {
+			case total == target:
+				return total
+			case total < target:
+				left++
+			default:
+				right--
+			}
+		}
+	}
+
+	return closest
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
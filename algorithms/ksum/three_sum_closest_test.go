@@ -0,0 +1,24 @@
+package ksum
+
+import "testing"
+
+func TestThreeSumClosest(t *testing.T) {
+	tests := []struct {
+		name   string
+		nums   []int
+		target int
+		want   int
+	}{
+		{"classic", []int{-1, 2, 1, -4}, 1, 2},
+		{"single triplet", []int{0, 0, 0}, 1, 0},
+		{"all negative", []int{-3, -2, -1}, 0, -6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ThreeSumClosest(tt.nums, tt.target); got != tt.want {
+				t.Errorf("ThreeSumClosest(%v, %d) = %d, want %d", tt.nums, tt.target, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package ksum
+
+import "sort"
+
+// ThreeSumSmaller counts the triplets in nums (by index) whose sum is
+// strictly less than target.
+func ThreeSumSmaller(nums []int, target int) int {
+	sorted := append([]int(nil), nums...)
+	sort.Ints(sorted)
+
+	count := 0
+	for i := 0; i < len(sorted)-2; i++ {
+		left, right := i+1, len(sorted)-1
+		for left < right {
+			if sorted[i]+sorted[left]+sorted[right] < target {
+				count += right - left
+				left++
+			} else {
+				right--
+			}
+		}
+	}
+
+	return count
+}
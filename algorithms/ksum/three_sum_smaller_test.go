@@ -0,0 +1,24 @@
+package ksum
+
+import "testing"
+
+func TestThreeSumSmaller(t *testing.T) {
+	tests := []struct {
+		name   string
+		nums   []int
+		target int
+		want   int
+	}{
+		{"classic", []int{-2, 0, 1, 3}, 2, 2},
+		{"no triplets", []int{1, 2, 3}, 0, 0},
+		{"all qualify", []int{-3, -2, -1}, 100, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ThreeSumSmaller(tt.nums, tt.target); got != tt.want {
+				t.Errorf("ThreeSumSmaller(%v, %d) = %d, want %d", tt.nums, tt.target, got, tt.want)
+			}
+		})
+	}
+}
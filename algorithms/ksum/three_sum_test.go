@@ -0,0 +1,28 @@
+package ksum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestThreeSum(t *testing.T) {
+	tests := [][]int{
+		{-1, 0, 1, 2, -1, -4},
+		{0, 0, 0},
+		{},
+		{1, 2, -2, -1},
+		{-2, 0, 1, 1, 2},
+		{-4, -2, -2, -2, 0, 1, 2, 2, 2, 3, 3, 4, 4, 6, 6},
+		{-5, 1, 10, -1, -2, 3, 4, -3, 0},
+		{-10, 5, 2, 4, -4, -5, 0, 0},
+	}
+
+	for i, nums := range tests {
+		got := normalize(ThreeSum(nums))
+		want := normalize(bruteForceKSum(nums, 0, 3))
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("test case %d: ThreeSum(%v) = %v, want %v", i+1, nums, got, want)
+		}
+	}
+}
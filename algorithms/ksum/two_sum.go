@@ -0,0 +1,6 @@
+package ksum
+
+// TwoSum returns every pair of values in nums that sums to target.
+func TwoSum(nums []int, target int) [][]int {
+	return KSum(nums, target, 2)
+}
@@ -0,0 +1,29 @@
+package ksum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTwoSum(t *testing.T) {
+	tests := []struct {
+		name   string
+		nums   []int
+		target int
+		want   [][]int
+	}{
+		{"one pair", []int{2, 7, 11, 15}, 9, [][]int{{2, 7}}},
+		{"no pair", []int{1, 2, 3}, 100, nil},
+		{"duplicate values", []int{3, 3}, 6, [][]int{{3, 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := normalize(TwoSum(tt.nums, tt.target))
+			want := normalize(tt.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("TwoSum(%v, %d) = %v, want %v", tt.nums, tt.target, got, want)
+			}
+		})
+	}
+}
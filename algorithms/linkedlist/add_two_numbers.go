@@ -0,0 +1,41 @@
+package linkedlist
+
+// AddReverse adds two non-negative integers whose digits are stored with the
+// ones digit first (LeetCode 2: Add Two Numbers) and returns the sum in the
+// same reversed order.
+func AddReverse(l1, l2 *Node[int]) *Node[int] {
+	dummy := &Node[int]{}
+	cur := dummy
+	carry := 0
+
+	for l1 != nil || l2 != nil || carry != 0 {
+		sum := carry
+		if l1 != nil {
+			sum += l1.Value
+			l1 = l1.Next
+		}
+		if l2 != nil {
+			sum += l2.Value
+			l2 = l2.Next
+		}
+
+		carry = sum / 10
+		cur.Next = &Node[int]{Value: sum % 10}
+		cur = cur.Next
+	}
+
+	return dummy.Next
+}
+
+// AddForward adds two non-negative integers whose digits are stored with the
+// most significant digit first (CTCI 2.5, forward variant) and returns the
+// sum in the same forward order. The input lists are restored to their
+// original order before returning.
+func AddForward(l1, l2 *Node[int]) *Node[int] {
+	r1, r2 := Reverse(l1), Reverse(l2)
+	sum := AddReverse(r1, r2)
+	Reverse(r1)
+	Reverse(r2)
+
+	return Reverse(sum)
+}
@@ -0,0 +1,53 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAddReverse(t *testing.T) {
+	tests := []struct {
+		name   string
+		l1, l2 []int
+		want   []int
+	}{
+		{"342 + 465 = 807", []int{2, 4, 3}, []int{5, 6, 4}, []int{7, 0, 8}},
+		{"carry into new digit", []int{9, 9}, []int{1}, []int{0, 0, 1}},
+		{"different lengths", []int{9}, []int{1, 9}, []int{0, 0, 1}},
+		{"zero plus zero", []int{0}, []int{0}, []int{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToSlice(AddReverse(FromSlice(tt.l1), FromSlice(tt.l2)))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AddReverse(%v, %v) = %v, want %v", tt.l1, tt.l2, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddForward(t *testing.T) {
+	tests := []struct {
+		name   string
+		l1, l2 []int
+		want   []int
+	}{
+		{"342 + 465 = 807", []int{3, 4, 2}, []int{4, 6, 5}, []int{8, 0, 7}},
+		{"carry into new digit", []int{9, 9}, []int{1}, []int{1, 0, 0}},
+		{"different lengths", []int{9}, []int{9, 1}, []int{1, 0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l1, l2 := FromSlice(tt.l1), FromSlice(tt.l2)
+			got := ToSlice(AddForward(l1, l2))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AddForward(%v, %v) = %v, want %v", tt.l1, tt.l2, got, tt.want)
+			}
+			if !reflect.DeepEqual(ToSlice(l1), tt.l1) || !reflect.DeepEqual(ToSlice(l2), tt.l2) {
+				t.Errorf("AddForward mutated its inputs: l1=%v l2=%v", ToSlice(l1), ToSlice(l2))
+			}
+		})
+	}
+}
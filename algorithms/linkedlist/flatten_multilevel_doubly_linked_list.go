@@ -0,0 +1,32 @@
+package linkedlist
+
+// FlattenMultilevel flattens a multilevel doubly linked list (LeetCode 430):
+// whenever a node has a Child, the child list is spliced in between that
+// node and its Next, and the Child pointer is cleared. The result is a
+// single-level doubly linked list visited in depth-first order.
+func FlattenMultilevel[T any](head *DNode[T]) *DNode[T] {
+	for cur := head; cur != nil; cur = cur.Next {
+		if cur.Child == nil {
+			continue
+		}
+
+		next := cur.Next
+		child := cur.Child
+		cur.Child = nil
+
+		cur.Next = child
+		child.Prev = cur
+
+		tail := child
+		for tail.Next != nil {
+			tail = tail.Next
+		}
+
+		tail.Next = next
+		if next != nil {
+			next.Prev = tail
+		}
+	}
+
+	return head
+}
@@ -0,0 +1,76 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func dnodeFromSlice(values []int) *DNode[int] {
+	dummy := &DNode[int]{}
+	prev := dummy
+	for _, v := range values {
+		n := &DNode[int]{Value: v, Prev: prev}
+		prev.Next = n
+		prev = n
+	}
+
+	return dummy.Next
+}
+
+func dnodeToSlice(head *DNode[int]) []int {
+	var out []int
+	for n := head; n != nil; n = n.Next {
+		out = append(out, n.Value)
+	}
+
+	return out
+}
+
+func TestFlattenMultilevel(t *testing.T) {
+	t.Run("child in the middle", func(t *testing.T) {
+		// 1 - 2 - 3 - 4 - 5
+		//         |
+		//         6 - 7
+		head := dnodeFromSlice([]int{1, 2, 3, 4, 5})
+		child := dnodeFromSlice([]int{6, 7})
+		head.Next.Next.Child = child // attach under node 3
+
+		flat := FlattenMultilevel(head)
+		want := []int{1, 2, 3, 6, 7, 4, 5}
+		if got := dnodeToSlice(flat); !reflect.DeepEqual(got, want) {
+			t.Errorf("FlattenMultilevel() = %v, want %v", got, want)
+		}
+
+		// Prev pointers must stay consistent after flattening.
+		for n := flat; n != nil && n.Next != nil; n = n.Next {
+			if n.Next.Prev != n {
+				t.Errorf("broken Prev link after node %d", n.Value)
+			}
+		}
+	})
+
+	t.Run("no children", func(t *testing.T) {
+		head := dnodeFromSlice([]int{1, 2, 3})
+		want := []int{1, 2, 3}
+		if got := dnodeToSlice(FlattenMultilevel(head)); !reflect.DeepEqual(got, want) {
+			t.Errorf("FlattenMultilevel() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("nested children", func(t *testing.T) {
+		// 1 - 2
+		//     |
+		//     3
+		//     |
+		//     4
+		head := dnodeFromSlice([]int{1, 2})
+		inner := dnodeFromSlice([]int{3})
+		inner.Child = dnodeFromSlice([]int{4})
+		head.Next.Child = inner
+
+		want := []int{1, 2, 3, 4}
+		if got := dnodeToSlice(FlattenMultilevel(head)); !reflect.DeepEqual(got, want) {
+			t.Errorf("FlattenMultilevel() = %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package linkedlist
+
+// DetectCycle finds the node where a cycle begins, or nil if the list has no
+// cycle. It uses Floyd's tortoise-and-hare: slow advances by 1 and fast by 2
+// until they meet inside the cycle, then slow resets to head and both
+// advance by 1 until they meet again at the cycle's entry point.
+func DetectCycle[T any](head *Node[T]) *Node[T] {
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+
+		if slow == fast {
+			slow = head
+			for slow != fast {
+				slow = slow.Next
+				fast = fast.Next
+			}
+
+			return slow
+		}
+	}
+
+	return nil
+}
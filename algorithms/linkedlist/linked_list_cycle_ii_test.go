@@ -0,0 +1,32 @@
+package linkedlist
+
+import "testing"
+
+func TestDetectCycle(t *testing.T) {
+	t.Run("cycle at second node", func(t *testing.T) {
+		n3 := &Node[int]{Value: 3}
+		n2 := &Node[int]{Value: 2, Next: n3}
+		n1 := &Node[int]{Value: 1, Next: n2}
+		n3.Next = n2
+
+		if got := DetectCycle(n1); got != n2 {
+			t.Errorf("DetectCycle() = %v, want %v", got, n2)
+		}
+	})
+
+	t.Run("no cycle", func(t *testing.T) {
+		head := FromSlice([]int{1, 2, 3})
+		if got := DetectCycle(head); got != nil {
+			t.Errorf("DetectCycle() = %v, want nil", got)
+		}
+	})
+
+	t.Run("self loop", func(t *testing.T) {
+		n1 := &Node[int]{Value: 1}
+		n1.Next = n1
+
+		if got := DetectCycle(n1); got != n1 {
+			t.Errorf("DetectCycle() = %v, want %v", got, n1)
+		}
+	})
+}
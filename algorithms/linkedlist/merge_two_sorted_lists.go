@@ -0,0 +1,28 @@
+package linkedlist
+
+// MergeSorted merges two sorted lists into one sorted list, reusing nodes
+// from both inputs.
+func MergeSorted[T Ordered](l1, l2 *Node[T]) *Node[T] {
+	dummy := &Node[T]{}
+	cur := dummy
+
+	for l1 != nil && l2 != nil {
+		if l1.Value <= l2.Value {
+			cur.Next = l1
+			l1 = l1.Next
+		} else {
+			cur.Next = l2
+			l2 = l2.Next
+		}
+
+		cur = cur.Next
+	}
+
+	if l1 != nil {
+		cur.Next = l1
+	} else {
+		cur.Next = l2
+	}
+
+	return dummy.Next
+}
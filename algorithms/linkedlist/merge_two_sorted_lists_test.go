@@ -0,0 +1,28 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name   string
+		l1, l2 []int
+		want   []int
+	}{
+		{"interleaved", []int{1, 2, 4}, []int{1, 3, 4}, []int{1, 1, 2, 3, 4, 4}},
+		{"first empty", []int{}, []int{0}, []int{0}},
+		{"second empty", []int{1, 2}, []int{}, []int{1, 2}},
+		{"both empty", []int{}, []int{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToSlice(MergeSorted(FromSlice(tt.l1), FromSlice(tt.l2)))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeSorted(%v, %v) = %v, want %v", tt.l1, tt.l2, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Package linkedlist collects classic linked-list algorithms over a small
+// generic node type, so individual problems can share the same building
+// blocks instead of redefining ListNode in every file.
+package linkedlist
+
+// Node is a singly linked list node holding a value of any type.
+type Node[T any] struct {
+	Value T
+	Next  *Node[T]
+}
+
+// DNode is a doubly linked list node that also carries a Child pointer,
+// used by problems that flatten a multilevel list (e.g. LeetCode 430).
+type DNode[T any] struct {
+	Value T
+	Prev  *DNode[T]
+	Next  *DNode[T]
+	Child *DNode[T]
+}
+
+// Ordered constrains types that support the < operator, mirroring
+// cmp.Ordered without pulling in an extra dependency.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// FromSlice builds a list from values in order and returns its head.
+func FromSlice[T any](values []T) *Node[T] {
+	dummy := &Node[T]{}
+	cur := dummy
+	for _, v := range values {
+		cur.Next = &Node[T]{Value: v}
+		cur = cur.Next
+	}
+
+	return dummy.Next
+}
+
+// ToSlice collects the values of a list in order.
+func ToSlice[T any](head *Node[T]) []T {
+	var out []T
+	for n := head; n != nil; n = n.Next {
+		out = append(out, n.Value)
+	}
+
+	return out
+}
@@ -0,0 +1,33 @@
+package linkedlist
+
+// IsPalindrome reports whether the list reads the same forward and backward.
+// It finds the midpoint with a slow/fast pointer, reverses the second half in
+// place, and walks both halves together, for O(1) extra space.
+func IsPalindrome[T comparable](head *Node[T]) bool {
+	if head == nil || head.Next == nil {
+		return true
+	}
+
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+
+	secondHalf := Reverse(slow)
+
+	first, second := head, secondHalf
+	result := true
+	for second != nil {
+		if first.Value != second.Value {
+			result = false
+			break
+		}
+
+		first = first.Next
+		second = second.Next
+	}
+
+	Reverse(secondHalf)
+	return result
+}
@@ -0,0 +1,29 @@
+package linkedlist
+
+import "testing"
+
+func TestIsPalindrome(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want bool
+	}{
+		{"odd palindrome", []int{1, 2, 3, 2, 1}, true},
+		{"even palindrome", []int{1, 2, 2, 1}, true},
+		{"not a palindrome", []int{1, 2, 3}, false},
+		{"single", []int{1}, true},
+		{"empty", []int{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			head := FromSlice(tt.in)
+			if got := IsPalindrome(head); got != tt.want {
+				t.Errorf("IsPalindrome(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+			if got := ToSlice(head); len(got) != len(tt.in) {
+				t.Errorf("IsPalindrome(%v) mutated the list: got %v", tt.in, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package linkedlist
+
+// RemoveDuplicates removes consecutive duplicate values from a sorted list
+// in place, keeping the first occurrence of each value.
+func RemoveDuplicates[T comparable](head *Node[T]) *Node[T] {
+	for cur := head; cur != nil && cur.Next != nil; {
+		if cur.Value == cur.Next.Value {
+			cur.Next = cur.Next.Next
+			continue
+		}
+
+		cur = cur.Next
+	}
+
+	return head
+}
@@ -0,0 +1,28 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRemoveDuplicates(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"several duplicates", []int{1, 1, 2, 3, 3}, []int{1, 2, 3}},
+		{"no duplicates", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"all same", []int{1, 1, 1}, []int{1}},
+		{"empty", []int{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToSlice(RemoveDuplicates(FromSlice(tt.in)))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RemoveDuplicates(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
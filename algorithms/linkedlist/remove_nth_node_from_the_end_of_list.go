@@ -0,0 +1,28 @@
+package linkedlist
+
+// RemoveNthFromEnd removes the nth node from the end of the linked list and returns the modified list.
+func RemoveNthFromEnd[T any](head *Node[T], n int) *Node[T] {
+	if head == nil || n <= 0 {
+		return head
+	}
+
+	dummy := &Node[T]{Next: head}
+	rightPtr := dummy
+	leftPtr := dummy
+
+	for i := 0; i < n; i++ {
+		if rightPtr.Next == nil {
+			return head
+		}
+
+		rightPtr = rightPtr.Next
+	}
+
+	for rightPtr.Next != nil {
+		rightPtr = rightPtr.Next
+		leftPtr = leftPtr.Next
+	}
+
+	leftPtr.Next = leftPtr.Next.Next
+	return dummy.Next
+}
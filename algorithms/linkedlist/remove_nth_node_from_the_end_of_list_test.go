@@ -0,0 +1,31 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRemoveNthFromEnd(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		n    int
+		want []int
+	}{
+		{"middle", []int{1, 2, 3, 4, 5}, 2, []int{1, 2, 3, 5}},
+		{"head", []int{1, 2, 3, 4, 5}, 5, []int{2, 3, 4, 5}},
+		{"tail", []int{1, 2, 3, 4, 5}, 1, []int{1, 2, 3, 4}},
+		{"single", []int{1}, 1, nil},
+		{"empty", []int{}, 1, nil},
+		{"n too large", []int{1, 2, 3}, 5, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToSlice(RemoveNthFromEnd(FromSlice(tt.in), tt.n))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RemoveNthFromEnd(%v, %d) = %v, want %v", tt.in, tt.n, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package linkedlist
+
+// Reorder rearranges a list from L0,L1,...,Ln-1,Ln in place into
+// L0,Ln,L1,Ln-1,... by splitting it at the midpoint, reversing the second
+// half, and merging the two halves alternately.
+func Reorder[T any](head *Node[T]) {
+	if head == nil || head.Next == nil {
+		return
+	}
+
+	slow, fast := head, head
+	for fast.Next != nil && fast.Next.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+
+	second := Reverse(slow.Next)
+	slow.Next = nil
+
+	first := head
+	for second != nil {
+		firstNext, secondNext := first.Next, second.Next
+
+		first.Next = second
+		second.Next = firstNext
+
+		first = firstNext
+		second = secondNext
+	}
+}
@@ -0,0 +1,29 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReorder(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"even length", []int{1, 2, 3, 4}, []int{1, 4, 2, 3}},
+		{"odd length", []int{1, 2, 3, 4, 5}, []int{1, 5, 2, 4, 3}},
+		{"single", []int{1}, []int{1}},
+		{"pair", []int{1, 2}, []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			head := FromSlice(tt.in)
+			Reorder(head)
+			if got := ToSlice(head); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Reorder(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package linkedlist
+
+// Reverse reverses a singly linked list in place and returns the new head.
+func Reverse[T any](head *Node[T]) *Node[T] {
+	var prev *Node[T]
+	cur := head
+
+	for cur != nil {
+		next := cur.Next
+		cur.Next = prev
+		prev = cur
+		cur = next
+	}
+
+	return prev
+}
@@ -0,0 +1,27 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"several", []int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+		{"single", []int{1}, []int{1}},
+		{"empty", []int{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToSlice(Reverse(FromSlice(tt.in)))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Reverse(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package palindrome
+
+// LongestPalindromicSubsequence returns the length of the longest
+// subsequence of s that is a palindrome, via the O(n^2) interval DP
+// dp[i][j] = dp[i+1][j-1]+2 if s[i]==s[j], else max(dp[i+1][j], dp[i][j-1]),
+// filled by increasing substring length.
+func LongestPalindromicSubsequence(s string) int {
+	n := len(s)
+	if n == 0 {
+		return 0
+	}
+
+	dp := make([][]int, n)
+	for i := range dp {
+		dp[i] = make([]int, n)
+		dp[i][i] = 1
+	}
+
+	for length := 2; length <= n; length++ {
+		for i := 0; i+length-1 < n; i++ {
+			j := i + length - 1
+			if s[i] == s[j] {
+				if length == 2 {
+					dp[i][j] = 2
+				} else {
+					dp[i][j] = dp[i+1][j-1] + 2
+				}
+			} else {
+				dp[i][j] = max(dp[i+1][j], dp[i][j-1])
+			}
+		}
+	}
+
+	return dp[0][n-1]
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
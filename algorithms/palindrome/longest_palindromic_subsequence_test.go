@@ -0,0 +1,25 @@
+package palindrome
+
+import "testing"
+
+func TestLongestPalindromicSubsequence(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"bbbab", "bbbab", 4},
+		{"cbbd", "cbbd", 2},
+		{"empty", "", 0},
+		{"single", "a", 1},
+		{"already a palindrome", "racecar", 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LongestPalindromicSubsequence(tt.in); got != tt.want {
+				t.Errorf("LongestPalindromicSubsequence(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
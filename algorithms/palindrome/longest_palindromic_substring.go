@@ -0,0 +1,34 @@
+package palindrome
+
+// LongestPalindromeSubstring returns the longest palindromic substring of s,
+// found by expanding around every center: once for the odd-length case
+// centered on (i, i) and once for the even-length case centered on
+// (i, i+1), keeping the widest span seen.
+func LongestPalindromeSubstring(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+
+	start, end := 0, 0
+
+	expand := func(l, r int) (int, int) {
+		for l >= 0 && r < len(s) && s[l] == s[r] {
+			l--
+			r++
+		}
+
+		return l + 1, r - 1
+	}
+
+	for i := 0; i < len(s); i++ {
+		if l, r := expand(i, i); r-l > end-start {
+			start, end = l, r
+		}
+
+		if l, r := expand(i, i+1); r-l > end-start {
+			start, end = l, r
+		}
+	}
+
+	return s[start : end+1]
+}
@@ -0,0 +1,29 @@
+package palindrome
+
+import "testing"
+
+func TestLongestPalindromeSubstring(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string // any of these is an acceptable answer
+	}{
+		{"odd center", "babad", []string{"bab", "aba"}},
+		{"even center", "cbbd", []string{"bb"}},
+		{"single char", "a", []string{"a"}},
+		{"all same", "aaaa", []string{"aaaa"}},
+		{"empty", "", []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LongestPalindromeSubstring(tt.in)
+			for _, want := range tt.want {
+				if got == want {
+					return
+				}
+			}
+			t.Errorf("LongestPalindromeSubstring(%q) = %q, want one of %v", tt.in, got, tt.want)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package palindrome
+
+import "strings"
+
+// Manacher runs Manacher's algorithm on s and returns the palindrome radius
+// at every position of the '#'-interleaved transform (e.g. "aba" becomes
+// "#a#b#a#"), computed in linear time by reusing previously discovered
+// palindromes via a rightmost-boundary mirror.
+func Manacher(s string) []int {
+	t := transform(s)
+	n := len(t)
+	radius := make([]int, n)
+
+	center, right := 0, 0
+	for i := 0; i < n; i++ {
+		if i < right {
+			mirror := 2*center - i
+			if right-i < radius[mirror] {
+				radius[i] = right - i
+			} else {
+				radius[i] = radius[mirror]
+			}
+		}
+
+		for i-radius[i]-1 >= 0 && i+radius[i]+1 < n && t[i-radius[i]-1] == t[i+radius[i]+1] {
+			radius[i]++
+		}
+
+		if i+radius[i] > right {
+			center, right = i, i+radius[i]
+		}
+	}
+
+	return radius
+}
+
+func transform(s string) string {
+	var b strings.Builder
+	b.WriteByte('#')
+	for i := 0; i < len(s); i++ {
+		b.WriteByte(s[i])
+		b.WriteByte('#')
+	}
+
+	return b.String()
+}
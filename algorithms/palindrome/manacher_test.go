@@ -0,0 +1,45 @@
+package palindrome
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestManacher(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []int
+	}{
+		{"aba", "aba", []int{0, 1, 0, 3, 0, 1, 0}},
+		{"single char", "a", []int{0, 1, 0}},
+		{"empty", "", []int{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Manacher(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Manacher(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManacherMaxRadiusMatchesLongestSubstring(t *testing.T) {
+	inputs := []string{"babad", "cbbd", "aaaa", "abcde"}
+
+	for _, s := range inputs {
+		radius := Manacher(s)
+
+		best := 0
+		for _, r := range radius {
+			if r > best {
+				best = r
+			}
+		}
+
+		if want := len(LongestPalindromeSubstring(s)); best != want {
+			t.Errorf("Manacher(%q) max radius = %d, want %d", s, best, want)
+		}
+	}
+}
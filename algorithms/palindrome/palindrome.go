@@ -0,0 +1,45 @@
+// Package palindrome collects the classic palindrome problem cluster:
+// substring and subsequence variants, Manacher's linear-time algorithm, and
+// palindrome partitioning.
+package palindrome
+
+import "unicode"
+
+// Reverse returns s with its runes in reverse order.
+func Reverse(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+
+	return string(r)
+}
+
+// IsPalindromeStrict reports whether s is a palindrome once non-alphanumeric
+// characters are discarded and case is ignored (LeetCode 125).
+func IsPalindromeStrict(s string) bool {
+	r := []rune(s)
+	left, right := 0, len(r)-1
+
+	for left < right {
+		for left < right && !isAlphanumeric(r[left]) {
+			left++
+		}
+		for left < right && !isAlphanumeric(r[right]) {
+			right--
+		}
+
+		if unicode.ToLower(r[left]) != unicode.ToLower(r[right]) {
+			return false
+		}
+
+		left++
+		right--
+	}
+
+	return true
+}
+
+func isAlphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,45 @@
+package palindrome
+
+// PartitionPalindromes returns every way to partition s into substrings that
+// are all palindromes. It precomputes isPal[i][j] (whether s[i:j+1] is a
+// palindrome) by interval DP, then depth-first searches over cut points,
+// using the table for O(1) palindrome checks instead of re-scanning.
+func PartitionPalindromes(s string) [][]string {
+	n := len(s)
+	isPal := make([][]bool, n)
+	for i := range isPal {
+		isPal[i] = make([]bool, n)
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for j := i; j < n; j++ {
+			if s[i] == s[j] && (j-i < 2 || isPal[i+1][j-1]) {
+				isPal[i][j] = true
+			}
+		}
+	}
+
+	var result [][]string
+	var path []string
+
+	var dfs func(start int)
+	dfs = func(start int) {
+		if start == n {
+			result = append(result, append([]string(nil), path...))
+			return
+		}
+
+		for end := start; end < n; end++ {
+			if !isPal[start][end] {
+				continue
+			}
+
+			path = append(path, s[start:end+1])
+			dfs(end + 1)
+			path = path[:len(path)-1]
+		}
+	}
+
+	dfs(0)
+	return result
+}
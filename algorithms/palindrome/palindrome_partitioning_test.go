@@ -0,0 +1,51 @@
+package palindrome
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPartitionPalindromes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want [][]string
+	}{
+		{
+			name: "aab",
+			in:   "aab",
+			want: [][]string{{"a", "a", "b"}, {"aa", "b"}},
+		},
+		{
+			name: "a",
+			in:   "a",
+			want: [][]string{{"a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PartitionPalindromes(tt.in)
+			sortPartitions(got)
+			sortPartitions(tt.want)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PartitionPalindromes(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func sortPartitions(partitions [][]string) {
+	sort.Slice(partitions, func(i, j int) bool {
+		a, b := partitions[i], partitions[j]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+
+		return len(a) < len(b)
+	})
+}
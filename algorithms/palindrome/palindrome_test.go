@@ -0,0 +1,44 @@
+package palindrome
+
+import "testing"
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"simple", "abc", "cba"},
+		{"palindrome", "racecar", "racecar"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reverse(tt.in); got != tt.want {
+				t.Errorf("Reverse(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsPalindromeStrict(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want bool
+	}{
+		{"classic sentence", "A man, a plan, a canal: Panama", true},
+		{"not a palindrome", "race a car", false},
+		{"empty after filtering", " ", true},
+		{"digit and letter differ", "0P", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPalindromeStrict(tt.in); got != tt.want {
+				t.Errorf("IsPalindromeStrict(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package palindrome
+
+// CountPalindromicSubstrings returns the number of palindromic substrings in
+// s, counting the same substring value multiple times if it occurs at
+// different positions. It expands around every center the same way
+// LongestPalindromeSubstring does, counting every expansion step.
+func CountPalindromicSubstrings(s string) int {
+	count := 0
+
+	expand := func(l, r int) {
+		for l >= 0 && r < len(s) && s[l] == s[r] {
+			count++
+			l--
+			r++
+		}
+	}
+
+	for i := 0; i < len(s); i++ {
+		expand(i, i)
+		expand(i, i+1)
+	}
+
+	return count
+}
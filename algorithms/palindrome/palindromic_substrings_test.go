@@ -0,0 +1,24 @@
+package palindrome
+
+import "testing"
+
+func TestCountPalindromicSubstrings(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"abc", "abc", 3},
+		{"aaa", "aaa", 6},
+		{"empty", "", 0},
+		{"single", "a", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountPalindromicSubstrings(tt.in); got != tt.want {
+				t.Errorf("CountPalindromicSubstrings(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
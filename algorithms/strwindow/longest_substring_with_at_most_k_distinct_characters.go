@@ -0,0 +1,43 @@
+package strwindow
+
+// LongestSubstringKDistinct returns the length of the longest substring of s
+// that contains at most k distinct characters.
+func LongestSubstringKDistinct(s string, k int) int {
+	if k <= 0 {
+		return 0
+	}
+
+	count := make(map[byte]int)
+	left := 0
+	lastRight := -1
+	best := 0
+
+	expand := func(right int) bool {
+		if right != lastRight {
+			count[s[right]]++
+			lastRight = right
+		}
+
+		if len(count) > k {
+			return true
+		}
+
+		if width := right - left + 1; width > best {
+			best = width
+		}
+
+		return false
+	}
+
+	shrink := func(l int) {
+		c := s[l]
+		count[c]--
+		if count[c] == 0 {
+			delete(count, c)
+		}
+		left = l + 1
+	}
+
+	slide(len(s), expand, shrink)
+	return best
+}
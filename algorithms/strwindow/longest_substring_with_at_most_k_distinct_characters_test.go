@@ -0,0 +1,26 @@
+package strwindow
+
+import "testing"
+
+func TestLongestSubstringKDistinct(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		k    int
+		want int
+	}{
+		{"classic", "eceba", 2, 3},
+		{"all same", "aaaa", 1, 4},
+		{"k zero", "abc", 0, 0},
+		{"k larger than alphabet", "abc", 5, 3},
+		{"empty", "", 2, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LongestSubstringKDistinct(tt.in, tt.k); got != tt.want {
+				t.Errorf("LongestSubstringKDistinct(%q, %d) = %d, want %d", tt.in, tt.k, got, tt.want)
+			}
+		})
+	}
+}
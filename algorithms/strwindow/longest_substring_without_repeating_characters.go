@@ -0,0 +1,27 @@
+package strwindow
+
+// LengthOfLongestSubstring returns the length of the longest substring of s
+// without repeating characters. It tracks the last index each byte was seen
+// at in a fixed 128-entry array, so the left edge can jump directly past a
+// repeat instead of shrinking one character at a time.
+func LengthOfLongestSubstring(s string) int {
+	var lastSeen [128]int
+	for i := range lastSeen {
+		lastSeen[i] = -1
+	}
+
+	best, left := 0, 0
+	for right := 0; right < len(s); right++ {
+		c := s[right]
+		if lastSeen[c] >= left {
+			left = lastSeen[c] + 1
+		}
+		lastSeen[c] = right
+
+		if width := right - left + 1; width > best {
+			best = width
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,25 @@
+package strwindow
+
+import "testing"
+
+func TestLengthOfLongestSubstring(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"classic", "abcabcbb", 3},
+		{"all repeats", "bbbbb", 1},
+		{"mixed", "pwwkew", 3},
+		{"empty", "", 0},
+		{"no repeats", "abcdef", 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LengthOfLongestSubstring(tt.in); got != tt.want {
+				t.Errorf("LengthOfLongestSubstring(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package strwindow
+
+// MinWindowSubstring returns the shortest substring of s that contains every
+// character of t (with at least its multiplicity in t), or "" if no such
+// window exists. It keeps a need/have counter pair and, unlike slide,
+// shrinks the window while it is still valid to find the minimum instead of
+// only when it becomes invalid, so it drives its own two-pointer loop.
+func MinWindowSubstring(s, t string) string {
+	if len(t) == 0 || len(s) < len(t) {
+		return ""
+	}
+
+	need := make(map[byte]int, len(t))
+	for i := 0; i < len(t); i++ {
+		need[t[i]]++
+	}
+
+	have := make(map[byte]int, len(need))
+	matched := 0
+	bestLen := len(s) + 1
+	bestStart := 0
+
+	left := 0
+	for right := 0; right < len(s); right++ {
+		c := s[right]
+		if _, ok := need[c]; ok {
+			have[c]++
+			if have[c] == need[c] {
+				matched++
+			}
+		}
+
+		for matched == len(need) {
+			if width := right - left + 1; width < bestLen {
+				bestLen = width
+				bestStart = left
+			}
+
+			lc := s[left]
+			if _, ok := need[lc]; ok {
+				if have[lc] == need[lc] {
+					matched--
+				}
+				have[lc]--
+			}
+			left++
+		}
+	}
+
+	if bestLen > len(s) {
+		return ""
+	}
+
+	return s[bestStart : bestStart+bestLen]
+}
@@ -0,0 +1,26 @@
+package strwindow
+
+import "testing"
+
+func TestMinWindowSubstring(t *testing.T) {
+	tests := []struct {
+		name string
+		s, t string
+		want string
+	}{
+		{"classic", "ADOBECODEBANC", "ABC", "BANC"},
+		{"single char present", "a", "a", "a"},
+		{"too few repeats of a char", "a", "aa", ""},
+		{"t longer than s", "a", "ab", ""},
+		{"no valid window", "abc", "x", ""},
+		{"t empty", "abc", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MinWindowSubstring(tt.s, tt.t); got != tt.want {
+				t.Errorf("MinWindowSubstring(%q, %q) = %q, want %q", tt.s, tt.t, got, tt.want)
+			}
+		})
+	}
+}
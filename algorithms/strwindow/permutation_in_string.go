@@ -0,0 +1,104 @@
+package strwindow
+
+// CheckPermutation reports whether s2 contains a permutation of s1, i.e.
+// whether one of s2's contiguous substrings is an anagram of s1. It slides a
+// fixed-size window of len(s1) over s2 and compares lowercase-letter counts.
+func CheckPermutation(s1, s2 string) bool {
+	if len(s1) > len(s2) {
+		return false
+	}
+
+	var need, window [26]int
+	for i := 0; i < len(s1); i++ {
+		need[s1[i]-'a']++
+	}
+
+	left := 0
+	lastRight := -1
+	found := false
+
+	expand := func(right int) bool {
+		if right != lastRight {
+			window[s2[right]-'a']++
+			lastRight = right
+		}
+
+		if right-left+1 > len(s1) {
+			return true
+		}
+
+		if right-left+1 == len(s1) && window == need {
+			found = true
+		}
+
+		return false
+	}
+
+	shrink := func(l int) {
+		window[s2[l]-'a']--
+		left = l + 1
+	}
+
+	slide(len(s2), expand, shrink)
+	return found
+}
+
+// checkPermutationMap is the same check backed by a map[byte]int counter
+// instead of a fixed [26]int array, kept around to benchmark the two
+// counter strategies against each other.
+func checkPermutationMap(s1, s2 string) bool {
+	if len(s1) > len(s2) {
+		return false
+	}
+
+	need := make(map[byte]int, 26)
+	for i := 0; i < len(s1); i++ {
+		need[s1[i]]++
+	}
+
+	window := make(map[byte]int, 26)
+	left := 0
+	lastRight := -1
+	found := false
+
+	countsEqual := func() bool {
+		if len(window) != len(need) {
+			return false
+		}
+		for c, n := range need {
+			if window[c] != n {
+				return false
+			}
+		}
+		return true
+	}
+
+	expand := func(right int) bool {
+		if right != lastRight {
+			window[s2[right]]++
+			lastRight = right
+		}
+
+		if right-left+1 > len(s1) {
+			return true
+		}
+
+		if right-left+1 == len(s1) && countsEqual() {
+			found = true
+		}
+
+		return false
+	}
+
+	shrink := func(l int) {
+		c := s2[l]
+		window[c]--
+		if window[c] == 0 {
+			delete(window, c)
+		}
+		left = l + 1
+	}
+
+	slide(len(s2), expand, shrink)
+	return found
+}
@@ -0,0 +1,44 @@
+package strwindow
+
+import "testing"
+
+func TestCheckPermutation(t *testing.T) {
+	tests := []struct {
+		name   string
+		s1, s2 string
+		want   bool
+	}{
+		{"permutation present", "ab", "eidbaooo", true},
+		{"permutation absent", "ab", "eidboaoo", false},
+		{"equal strings", "abc", "abc", true},
+		{"s1 longer than s2", "abcd", "abc", false},
+		{"repeated letters", "aab", "aabaa", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CheckPermutation(tt.s1, tt.s2); got != tt.want {
+				t.Errorf("CheckPermutation(%q, %q) = %v, want %v", tt.s1, tt.s2, got, tt.want)
+			}
+			if got := checkPermutationMap(tt.s1, tt.s2); got != tt.want {
+				t.Errorf("checkPermutationMap(%q, %q) = %v, want %v", tt.s1, tt.s2, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkCheckPermutation(b *testing.B) {
+	s1, s2 := "abcdefghij", "zzzzzjihgfedcbazzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzz"
+
+	b.Run("array", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			CheckPermutation(s1, s2)
+		}
+	})
+
+	b.Run("map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			checkPermutationMap(s1, s2)
+		}
+	})
+}
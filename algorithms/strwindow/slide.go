@@ -0,0 +1,20 @@
+// Package strwindow collects substring problems solved with the
+// left-pointer-driven sliding-window technique.
+package strwindow
+
+// slide runs the standard sliding-window template over the index range
+// [0, n). For each right endpoint, expand(right) folds s[right] into the
+// window (once per index) and reports whether the window is currently
+// invalid; whenever it is, shrink(left) drops s[left] from the window and
+// the left edge advances, and expand is consulted again until the window
+// recovers. This lets each problem supply only its own notion of "invalid"
+// and its own window bookkeeping.
+func slide(n int, expand func(right int) bool, shrink func(left int)) {
+	left := 0
+	for right := 0; right < n; right++ {
+		for expand(right) {
+			shrink(left)
+			left++
+		}
+	}
+}